@@ -0,0 +1,217 @@
+// Package events subscribes to Slack's RTM/Events API and turns
+// message_changed, message_deleted and reaction_added/removed payloads into
+// MCP notifications, filtering them through handler.IsNotificationAllowed
+// so muted channels stop generating notifications. It sits next to
+// pkg/handler rather than inside it because it owns its own long-lived
+// subscription and per-session state, where handler's tools are
+// request/response.
+package events
+
+import (
+	"container/list"
+	"fmt"
+	"sync"
+
+	"github.com/hongkongkiwi/slack-mcp-server/pkg/handler"
+)
+
+// Mode selects how an edited message is surfaced to an MCP client.
+type Mode string
+
+const (
+	// ModeRaw delivers every edit as a new MCP message carrying the
+	// original thread_ts alongside an edited_ts field.
+	ModeRaw Mode = "raw"
+
+	// ModeNative reuses the same MCP message id across edits of the same
+	// Slack message, so agent memory stays consistent with a single
+	// message/update notification per edit instead of a growing history.
+	ModeNative Mode = "native"
+)
+
+// NotificationType identifies which Slack event produced a Notification.
+type NotificationType string
+
+const (
+	NotificationMessageChanged  NotificationType = "message_changed"
+	NotificationMessageDeleted  NotificationType = "message_deleted"
+	NotificationReactionAdded   NotificationType = "reaction_added"
+	NotificationReactionRemoved NotificationType = "reaction_removed"
+)
+
+// Notification is what a Subscriber hands to its emit callback. MessageID is
+// only populated in ModeNative.
+type Notification struct {
+	Type      NotificationType
+	ChannelID string
+	ThreadTs  string
+	EditedTs  string
+	MessageID string
+	Text      string
+	Reaction  string
+}
+
+// EmitFunc delivers a Notification to the MCP client; Subscriber calls it
+// synchronously for every accepted event.
+type EmitFunc func(Notification)
+
+// Subscriber turns validated Slack RTM/Events API payloads into
+// Notifications for a single MCP session.
+type Subscriber struct {
+	mode      Mode
+	emit      EmitFunc
+	index     *messageIndex
+	muteStore handler.MuteStore
+}
+
+// NewSubscriber creates a Subscriber for one session. capacity bounds the
+// native-mode LRU of (channel, ts) -> message id; it is ignored in ModeRaw.
+// muteStore may be nil, meaning no mute list is configured; when set, a
+// channel that's muted (or while global mute-all is on) has its
+// notifications filtered here rather than delivered, per
+// handler.IsNotificationAllowed.
+func NewSubscriber(mode Mode, capacity int, muteStore handler.MuteStore, emit EmitFunc) *Subscriber {
+	return &Subscriber{
+		mode:      mode,
+		emit:      emit,
+		index:     newMessageIndex(capacity),
+		muteStore: muteStore,
+	}
+}
+
+// HandleMessageChanged validates an incoming message_changed payload and
+// emits the appropriate notification for the subscriber's mode.
+func (s *Subscriber) HandleMessageChanged(channelID, threadTs, editedTs, text string) error {
+	if err := handler.ValidateChannelID(channelID); err != nil {
+		return fmt.Errorf("message_changed: %w", err)
+	}
+	if err := handler.ValidateThreadTimestamp(threadTs); err != nil {
+		return fmt.Errorf("message_changed: %w", err)
+	}
+
+	n := Notification{
+		Type:      NotificationMessageChanged,
+		ChannelID: channelID,
+		ThreadTs:  threadTs,
+		EditedTs:  editedTs,
+		Text:      text,
+	}
+
+	if s.mode == ModeNative {
+		n.MessageID = s.index.resolve(channelID, threadTs)
+	}
+
+	if handler.IsNotificationAllowed(channelID, s.muteStore) {
+		s.emit(n)
+	}
+	return nil
+}
+
+// HandleMessageDeleted validates an incoming message_deleted payload and
+// emits the deletion notification.
+func (s *Subscriber) HandleMessageDeleted(channelID, threadTs string) error {
+	if err := handler.ValidateChannelID(channelID); err != nil {
+		return fmt.Errorf("message_deleted: %w", err)
+	}
+	if err := handler.ValidateThreadTimestamp(threadTs); err != nil {
+		return fmt.Errorf("message_deleted: %w", err)
+	}
+
+	n := Notification{
+		Type:      NotificationMessageDeleted,
+		ChannelID: channelID,
+		ThreadTs:  threadTs,
+	}
+	if s.mode == ModeNative {
+		n.MessageID = s.index.resolve(channelID, threadTs)
+	}
+
+	if handler.IsNotificationAllowed(channelID, s.muteStore) {
+		s.emit(n)
+	}
+	return nil
+}
+
+// HandleReaction validates an incoming reaction_added/removed payload and
+// emits the corresponding notification.
+func (s *Subscriber) HandleReaction(channelID, threadTs, reaction string, added bool) error {
+	if err := handler.ValidateChannelID(channelID); err != nil {
+		return fmt.Errorf("reaction event: %w", err)
+	}
+	if err := handler.ValidateThreadTimestamp(threadTs); err != nil {
+		return fmt.Errorf("reaction event: %w", err)
+	}
+
+	typ := NotificationReactionRemoved
+	if added {
+		typ = NotificationReactionAdded
+	}
+
+	if handler.IsNotificationAllowed(channelID, s.muteStore) {
+		s.emit(Notification{
+			Type:      typ,
+			ChannelID: channelID,
+			ThreadTs:  threadTs,
+			Reaction:  reaction,
+		})
+	}
+	return nil
+}
+
+type messageKey struct {
+	channelID string
+	threadTs  string
+}
+
+// messageIndex is a capacity-bounded LRU mapping (channel, ts) to the MCP
+// message id last emitted for it, so ModeNative can reuse ids across edits.
+type messageIndex struct {
+	mu       sync.Mutex
+	capacity int
+	nextID   int
+	entries  map[messageKey]*list.Element
+	order    *list.List // front = most recently used
+}
+
+type messageIndexEntry struct {
+	key messageKey
+	id  string
+}
+
+func newMessageIndex(capacity int) *messageIndex {
+	if capacity <= 0 {
+		capacity = 1
+	}
+	return &messageIndex{
+		capacity: capacity,
+		entries:  make(map[messageKey]*list.Element),
+		order:    list.New(),
+	}
+}
+
+// resolve returns the existing message id for key if one has been emitted
+// before, otherwise it mints a new one and evicts the least recently used
+// entry if the index is at capacity.
+func (m *messageIndex) resolve(channelID, threadTs string) string {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	key := messageKey{channelID: channelID, threadTs: threadTs}
+	if el, ok := m.entries[key]; ok {
+		m.order.MoveToFront(el)
+		return el.Value.(*messageIndexEntry).id
+	}
+
+	if m.order.Len() >= m.capacity {
+		oldest := m.order.Back()
+		if oldest != nil {
+			m.order.Remove(oldest)
+			delete(m.entries, oldest.Value.(*messageIndexEntry).key)
+		}
+	}
+
+	m.nextID++
+	entry := &messageIndexEntry{key: key, id: fmt.Sprintf("msg-%d", m.nextID)}
+	m.entries[key] = m.order.PushFront(entry)
+	return entry.id
+}
@@ -0,0 +1,118 @@
+package events
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hongkongkiwi/slack-mcp-server/pkg/handler"
+)
+
+// allowAll is an isChannelAllowed-equivalent env setting: with no mute
+// store, notifications only flow when the message tool whitelist allows
+// the channel, so tests that aren't exercising mute filtering opt every
+// channel in.
+func allowAll(t *testing.T) {
+	t.Helper()
+	t.Setenv("SLACK_MCP_ADD_MESSAGE_TOOL", "true")
+}
+
+func TestSubscriberRawModeDoesNotReuseMessageID(t *testing.T) {
+	allowAll(t)
+	var got []Notification
+	s := NewSubscriber(ModeRaw, 10, nil, func(n Notification) { got = append(got, n) })
+
+	require.NoError(t, s.HandleMessageChanged("C1234567890", "1234567890.123456", "1234567890.999999", "edited once"))
+	require.NoError(t, s.HandleMessageChanged("C1234567890", "1234567890.123456", "1234567890.999998", "edited twice"))
+
+	require.Len(t, got, 2)
+	assert.Empty(t, got[0].MessageID)
+	assert.Empty(t, got[1].MessageID)
+}
+
+func TestSubscriberNativeModeReusesMessageID(t *testing.T) {
+	allowAll(t)
+	var got []Notification
+	s := NewSubscriber(ModeNative, 10, nil, func(n Notification) { got = append(got, n) })
+
+	require.NoError(t, s.HandleMessageChanged("C1234567890", "1234567890.123456", "1234567890.999999", "edited once"))
+	require.NoError(t, s.HandleMessageChanged("C1234567890", "1234567890.123456", "1234567890.999998", "edited twice"))
+
+	require.Len(t, got, 2)
+	assert.NotEmpty(t, got[0].MessageID)
+	assert.Equal(t, got[0].MessageID, got[1].MessageID)
+}
+
+func TestSubscriberRejectsInvalidPayload(t *testing.T) {
+	allowAll(t)
+	s := NewSubscriber(ModeRaw, 10, nil, func(Notification) {})
+
+	assert.Error(t, s.HandleMessageChanged("not-a-channel", "1234567890.123456", "", "text"))
+	assert.Error(t, s.HandleMessageDeleted("C1234567890", "not-a-timestamp"))
+	assert.Error(t, s.HandleReaction("C1234567890", "not-a-timestamp", "+1", true))
+}
+
+func TestSubscriberFiltersMutedChannel(t *testing.T) {
+	allowAll(t)
+	store := handler.NewInMemoryMuteStore()
+	require.NoError(t, store.Mute("C1234567890"))
+
+	var got []Notification
+	s := NewSubscriber(ModeRaw, 10, store, func(n Notification) { got = append(got, n) })
+
+	require.NoError(t, s.HandleMessageChanged("C1234567890", "1234567890.123456", "1234567890.999999", "edited"))
+	assert.Empty(t, got, "muted channel should not produce a notification")
+
+	require.NoError(t, s.HandleMessageChanged("C9999999999", "1234567890.123456", "1234567890.999999", "edited"))
+	assert.Len(t, got, 1, "a channel that isn't muted should still notify")
+}
+
+func TestSubscriberFiltersOnGlobalMute(t *testing.T) {
+	allowAll(t)
+	store := handler.NewInMemoryMuteStore()
+	require.NoError(t, store.SetGlobalMute(true))
+
+	var got []Notification
+	s := NewSubscriber(ModeRaw, 10, store, func(n Notification) { got = append(got, n) })
+
+	require.NoError(t, s.HandleMessageDeleted("C1234567890", "1234567890.123456"))
+	require.NoError(t, s.HandleReaction("C1234567890", "1234567890.123456", "+1", true))
+	assert.Empty(t, got)
+}
+
+func TestMessageIndexEvictsLeastRecentlyUsed(t *testing.T) {
+	idx := newMessageIndex(3)
+
+	a := idx.resolve("C1", "1.1")
+	b := idx.resolve("C2", "2.2")
+	idx.resolve("C3", "3.3")
+
+	// Touch "C1"/"1.1" again so "C2"/"2.2" becomes the least recently used
+	// of the three resident entries.
+	idx.resolve("C1", "1.1")
+
+	// Inserting a 4th key at capacity 3 evicts "C2"/"2.2", the LRU entry.
+	idx.resolve("C4", "4.4")
+
+	// C2/2.2 was evicted, so resolving it again mints a new id.
+	evicted := idx.resolve("C2", "2.2")
+	assert.NotEqual(t, b, evicted)
+
+	// C1/1.1 was touched more recently than C2/2.2 and survived the eviction.
+	assert.Equal(t, a, idx.resolve("C1", "1.1"))
+}
+
+func TestReadMarkersUnreadCount(t *testing.T) {
+	r := NewReadMarkers()
+
+	assert.Equal(t, 3, r.UnreadCount("C1234567890", []string{"1.1", "2.2", "3.3"}))
+
+	r.Mark("C1234567890", "2.2")
+	assert.Equal(t, "2.2", r.LastRead("C1234567890"))
+	assert.Equal(t, 1, r.UnreadCount("C1234567890", []string{"1.1", "2.2", "3.3"}))
+
+	// Marking an older ts does not move the high-water mark backwards.
+	r.Mark("C1234567890", "1.1")
+	assert.Equal(t, "2.2", r.LastRead("C1234567890"))
+}
@@ -0,0 +1,110 @@
+package events
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/hongkongkiwi/slack-mcp-server/pkg/handler"
+)
+
+// ReadMarkers tracks a XEP-0333-style high-water mark per channel: the
+// thread_ts of the last message the user has read. It backs the markRead
+// and getUnread MCP tools.
+type ReadMarkers struct {
+	mu    sync.Mutex
+	marks map[string]string // channelID -> last-read thread_ts
+}
+
+// NewReadMarkers returns an empty, ready-to-use ReadMarkers.
+func NewReadMarkers() *ReadMarkers {
+	return &ReadMarkers{marks: make(map[string]string)}
+}
+
+// Mark records ts as the high-water mark for channelID, advancing it only
+// if ts is newer than (or no) existing mark. Slack ts values sort
+// lexicographically the same as numerically since they share a fixed width.
+func (r *ReadMarkers) Mark(channelID, ts string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if current, ok := r.marks[channelID]; !ok || ts > current {
+		r.marks[channelID] = ts
+	}
+}
+
+// LastRead returns the high-water mark for channelID, or "" if the channel
+// has never been marked read.
+func (r *ReadMarkers) LastRead(channelID string) string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.marks[channelID]
+}
+
+// UnreadCount returns how many of the given message timestamps for a
+// channel are newer than its high-water mark. Callers supply the candidate
+// timestamps (e.g. from a channel history fetch) since ReadMarkers itself
+// holds no message data.
+func (r *ReadMarkers) UnreadCount(channelID string, messageTs []string) int {
+	mark := r.LastRead(channelID)
+
+	count := 0
+	for _, ts := range messageTs {
+		if ts > mark {
+			count++
+		}
+	}
+	return count
+}
+
+// ReadMarkerTool wires the markRead/getUnread MCP tools to a ReadMarkers,
+// forwarding the actual mark to Slack's conversations.mark so the server's
+// notion of "read" stays in sync with the workspace.
+type ReadMarkerTool struct {
+	markers     *ReadMarkers
+	markAsRead  func(channelID, ts string) error
+	listHistory func(channelID string) ([]string, error)
+}
+
+// NewReadMarkerTool returns a ReadMarkerTool. markAsRead should call Slack's
+// conversations.mark; listHistory should return the timestamps of recent
+// messages in a channel so getUnread has something to count against.
+func NewReadMarkerTool(markAsRead func(channelID, ts string) error, listHistory func(channelID string) ([]string, error)) *ReadMarkerTool {
+	return &ReadMarkerTool{
+		markers:     NewReadMarkers(),
+		markAsRead:  markAsRead,
+		listHistory: listHistory,
+	}
+}
+
+// MarkRead is the markRead MCP tool: it validates channel/ts, forwards the
+// mark to Slack, and records the local high-water mark.
+func (t *ReadMarkerTool) MarkRead(channelID, ts string) error {
+	if err := handler.ValidateChannelID(channelID); err != nil {
+		return fmt.Errorf("markRead: %w", err)
+	}
+	if err := handler.ValidateThreadTimestamp(ts); err != nil {
+		return fmt.Errorf("markRead: %w", err)
+	}
+
+	if err := t.markAsRead(channelID, ts); err != nil {
+		return fmt.Errorf("markRead: conversations.mark failed: %w", err)
+	}
+
+	t.markers.Mark(channelID, ts)
+	return nil
+}
+
+// GetUnread is the getUnread MCP tool: it returns how many messages in
+// channelID are newer than the locally recorded high-water mark.
+func (t *ReadMarkerTool) GetUnread(channelID string) (int, error) {
+	if err := handler.ValidateChannelID(channelID); err != nil {
+		return 0, fmt.Errorf("getUnread: %w", err)
+	}
+
+	messageTs, err := t.listHistory(channelID)
+	if err != nil {
+		return 0, fmt.Errorf("getUnread: %w", err)
+	}
+
+	return t.markers.UnreadCount(channelID, messageTs), nil
+}
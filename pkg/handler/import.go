@@ -0,0 +1,71 @@
+package handler
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/hongkongkiwi/slack-mcp-server/pkg/importer"
+)
+
+// ImportTool exposes a Slack export archive as an MCP tool: once loaded via
+// Import, its corpus can be searched offline by clients that don't have a
+// live Slack API token.
+type ImportTool struct {
+	corpus *importer.Corpus
+}
+
+// NewImportTool returns an ImportTool with no archive loaded yet.
+func NewImportTool() *ImportTool {
+	return &ImportTool{}
+}
+
+// Import loads a Slack export ZIP from zipPath, running every message
+// through the same content sanitizer the rest of the handler package uses
+// before anything is added to the searchable corpus.
+func (t *ImportTool) Import(zipPath string) error {
+	corpus, err := importer.Import(zipPath, sanitizeMessageContent)
+	if err != nil {
+		return fmt.Errorf("import slack export: %w", err)
+	}
+	t.corpus = corpus
+	return nil
+}
+
+// Search returns every imported message matching query, optionally scoped
+// to a single channel. channelID is validated against the corpus's own
+// naming scheme rather than validateChannelIdentifier: importer.Import
+// stores channel IDs as importer.ConvertChannelName's output (a bare
+// lowercase name like "general" or "eng-backend"), never a Slack object ID,
+// #-prefixed name, or @-mention, so the live-tool validator's format and
+// reserved-name rules don't apply here.
+func (t *ImportTool) Search(channelID, query string) ([]importer.Message, error) {
+	if t.corpus == nil {
+		return nil, fmt.Errorf("no slack export has been imported yet")
+	}
+	if channelID != "" {
+		if err := validateCorpusChannelID(channelID); err != nil {
+			return nil, fmt.Errorf("invalid channel: %w", err)
+		}
+	}
+
+	return t.corpus.Search(channelID, query), nil
+}
+
+// corpusChannelCharSet is channelNameCharSet plus "_", which
+// importer.ConvertChannelName keeps inside a name.
+var corpusChannelCharSet = regexp.MustCompile(`^[a-z0-9._-]+$`)
+
+// validateCorpusChannelID performs the minimal check appropriate for a
+// channel ID as produced by importer.ConvertChannelName: bounded length and
+// the character set of a live #-prefixed channel name plus the underscore,
+// but without the reserved-name blocklist, since an imported archive can
+// legitimately contain a channel literally named "general".
+func validateCorpusChannelID(channelID string) error {
+	if len(channelID) > maxChannelNameLength {
+		return ErrChannelTooLong
+	}
+	if !corpusChannelCharSet.MatchString(channelID) {
+		return ErrChannelIllegalChar
+	}
+	return nil
+}
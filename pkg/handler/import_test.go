@@ -0,0 +1,81 @@
+package handler
+
+import (
+	"archive/zip"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// buildTestExport writes a minimal Slack export ZIP with one channel and one
+// message file, returning its path.
+func buildTestExport(t *testing.T) string {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), "export.zip")
+	f, err := os.Create(path)
+	require.NoError(t, err)
+	defer f.Close()
+
+	w := zip.NewWriter(f)
+
+	writeZipFile(t, w, "channels.json", `[{"id":"C1","name":"General"}]`)
+	writeZipFile(t, w, "users.json", `[{"id":"U1111","name":"alice"}]`)
+	writeZipFile(t, w, "general/2024-01-01.json", `[
+		{"type":"message","user":"U1111","text":"hello <@U1111>","ts":"1700000000.000001"}
+	]`)
+
+	require.NoError(t, w.Close())
+	return path
+}
+
+func writeZipFile(t *testing.T, w *zip.Writer, name, content string) {
+	t.Helper()
+	zf, err := w.Create(name)
+	require.NoError(t, err)
+	_, err = zf.Write([]byte(content))
+	require.NoError(t, err)
+}
+
+func TestImportToolSearchByCorpusChannelName(t *testing.T) {
+	tool := NewImportTool()
+	require.NoError(t, tool.Import(buildTestExport(t)))
+
+	// "general" is how importer.ConvertChannelName normalizes the
+	// "general" export folder; validateChannelIdentifier would reject this
+	// as a reserved name, but the corpus's own naming scheme allows it.
+	results, err := tool.Search("general", "hello")
+	require.NoError(t, err)
+	require.Len(t, results, 1)
+	assert.Equal(t, "hello @alice", results[0].Text)
+
+	results, err = tool.Search("general", "nothing-matches")
+	require.NoError(t, err)
+	assert.Empty(t, results)
+}
+
+func TestImportToolSearchAllChannels(t *testing.T) {
+	tool := NewImportTool()
+	require.NoError(t, tool.Import(buildTestExport(t)))
+
+	results, err := tool.Search("", "hello")
+	require.NoError(t, err)
+	assert.Len(t, results, 1)
+}
+
+func TestImportToolSearchRejectsOversizedChannelID(t *testing.T) {
+	tool := NewImportTool()
+	require.NoError(t, tool.Import(buildTestExport(t)))
+
+	_, err := tool.Search(string(make([]byte, maxChannelNameLength+1)), "hello")
+	assert.Error(t, err)
+}
+
+func TestImportToolSearchBeforeImport(t *testing.T) {
+	tool := NewImportTool()
+	_, err := tool.Search("general", "hello")
+	assert.Error(t, err)
+}
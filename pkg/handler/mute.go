@@ -0,0 +1,285 @@
+package handler
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sync"
+)
+
+// MuteStore persists a per-workspace channel mute list plus a global
+// "mute all" switch, mirroring telegabber's whole-chat mute behavior when
+// no channel is given.
+type MuteStore interface {
+	// Mute adds channelID to the mute list.
+	Mute(channelID string) error
+	// Unmute removes channelID from the mute list.
+	Unmute(channelID string) error
+	// IsMuted reports whether channelID is individually muted.
+	IsMuted(channelID string) (bool, error)
+	// List returns every individually muted channel.
+	List() ([]string, error)
+	// SetGlobalMute turns the "mute all" switch on or off.
+	SetGlobalMute(muted bool) error
+	// GlobalMuted reports whether "mute all" is currently on.
+	GlobalMuted() (bool, error)
+}
+
+// InMemoryMuteStore is a MuteStore that keeps its state only for the
+// lifetime of the process; it's the default when no persistence is
+// configured.
+type InMemoryMuteStore struct {
+	mu     sync.Mutex
+	muted  map[string]bool
+	global bool
+}
+
+// NewInMemoryMuteStore returns an empty InMemoryMuteStore.
+func NewInMemoryMuteStore() *InMemoryMuteStore {
+	return &InMemoryMuteStore{muted: make(map[string]bool)}
+}
+
+func (s *InMemoryMuteStore) Mute(channelID string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.muted[channelID] = true
+	return nil
+}
+
+func (s *InMemoryMuteStore) Unmute(channelID string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.muted, channelID)
+	return nil
+}
+
+func (s *InMemoryMuteStore) IsMuted(channelID string) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.muted[channelID], nil
+}
+
+func (s *InMemoryMuteStore) List() ([]string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	channels := make([]string, 0, len(s.muted))
+	for c := range s.muted {
+		channels = append(channels, c)
+	}
+	return channels, nil
+}
+
+func (s *InMemoryMuteStore) SetGlobalMute(muted bool) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.global = muted
+	return nil
+}
+
+func (s *InMemoryMuteStore) GlobalMuted() (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.global, nil
+}
+
+// fileMuteState is the on-disk representation a FileMuteStore reads and
+// writes as a whole on every mutation.
+type fileMuteState struct {
+	Global   bool     `json:"global"`
+	Channels []string `json:"channels"`
+}
+
+// FileMuteStore is a MuteStore backed by a single JSON file, so the mute
+// list survives a server restart.
+type FileMuteStore struct {
+	mu   sync.Mutex
+	path string
+}
+
+// NewFileMuteStore returns a FileMuteStore backed by path, creating an
+// empty state file there if one doesn't already exist.
+func NewFileMuteStore(path string) (*FileMuteStore, error) {
+	s := &FileMuteStore{path: path}
+	if _, err := os.Stat(path); os.IsNotExist(err) {
+		if err := s.write(fileMuteState{}); err != nil {
+			return nil, fmt.Errorf("initialize mute store at %q: %w", path, err)
+		}
+	}
+	return s, nil
+}
+
+func (s *FileMuteStore) read() (fileMuteState, error) {
+	data, err := os.ReadFile(s.path)
+	if err != nil {
+		return fileMuteState{}, fmt.Errorf("read mute store: %w", err)
+	}
+	var state fileMuteState
+	if err := json.Unmarshal(data, &state); err != nil {
+		return fileMuteState{}, fmt.Errorf("parse mute store: %w", err)
+	}
+	return state, nil
+}
+
+func (s *FileMuteStore) write(state fileMuteState) error {
+	data, err := json.MarshalIndent(state, "", "  ")
+	if err != nil {
+		return fmt.Errorf("marshal mute store: %w", err)
+	}
+	if err := os.WriteFile(s.path, data, 0o600); err != nil {
+		return fmt.Errorf("write mute store: %w", err)
+	}
+	return nil
+}
+
+func (s *FileMuteStore) Mute(channelID string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	state, err := s.read()
+	if err != nil {
+		return err
+	}
+	for _, c := range state.Channels {
+		if c == channelID {
+			return nil
+		}
+	}
+	state.Channels = append(state.Channels, channelID)
+	return s.write(state)
+}
+
+func (s *FileMuteStore) Unmute(channelID string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	state, err := s.read()
+	if err != nil {
+		return err
+	}
+	filtered := state.Channels[:0]
+	for _, c := range state.Channels {
+		if c != channelID {
+			filtered = append(filtered, c)
+		}
+	}
+	state.Channels = filtered
+	return s.write(state)
+}
+
+func (s *FileMuteStore) IsMuted(channelID string) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	state, err := s.read()
+	if err != nil {
+		return false, err
+	}
+	for _, c := range state.Channels {
+		if c == channelID {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (s *FileMuteStore) List() ([]string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	state, err := s.read()
+	if err != nil {
+		return nil, err
+	}
+	return state.Channels, nil
+}
+
+func (s *FileMuteStore) SetGlobalMute(muted bool) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	state, err := s.read()
+	if err != nil {
+		return err
+	}
+	state.Global = muted
+	return s.write(state)
+}
+
+func (s *FileMuteStore) GlobalMuted() (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	state, err := s.read()
+	if err != nil {
+		return false, err
+	}
+	return state.Global, nil
+}
+
+// MuteTool wires the muteChannel/unmuteChannel/listMutedChannels MCP tools
+// to a MuteStore.
+type MuteTool struct {
+	store MuteStore
+}
+
+// NewMuteTool returns a MuteTool backed by store.
+func NewMuteTool(store MuteStore) *MuteTool {
+	return &MuteTool{store: store}
+}
+
+// MuteChannel is the muteChannel MCP tool. An empty channelID turns on
+// global "mute all" mode instead of muting a single channel, mirroring
+// telegabber's whole-chat mute behavior.
+func (t *MuteTool) MuteChannel(channelID string) error {
+	if channelID == "" {
+		return t.store.SetGlobalMute(true)
+	}
+	if err := validateChannelIdentifier(channelID); err != nil {
+		return fmt.Errorf("muteChannel: %w", err)
+	}
+	return t.store.Mute(channelID)
+}
+
+// UnmuteChannel is the unmuteChannel MCP tool. An empty channelID turns off
+// global "mute all" mode.
+func (t *MuteTool) UnmuteChannel(channelID string) error {
+	if channelID == "" {
+		return t.store.SetGlobalMute(false)
+	}
+	if err := validateChannelIdentifier(channelID); err != nil {
+		return fmt.Errorf("unmuteChannel: %w", err)
+	}
+	return t.store.Unmute(channelID)
+}
+
+// ListMutedChannels is the listMutedChannels MCP tool.
+func (t *MuteTool) ListMutedChannels() ([]string, error) {
+	return t.store.List()
+}
+
+// isNotificationAllowed is the third precedence tier layered on top of
+// isChannelAllowed: the mute list overrides the SLACK_MCP_ADD_MESSAGE_TOOL
+// whitelist/blacklist for notification delivery (messages/new), but has no
+// effect on explicit fetch tools, which call isChannelAllowed directly. A
+// MuteStore error is treated as muted rather than silently ignored, since
+// failing closed just suppresses a notification instead of leaking one the
+// user asked to mute.
+func isNotificationAllowed(channelID string, store MuteStore) bool {
+	if store != nil {
+		if global, err := store.GlobalMuted(); err != nil || global {
+			return false
+		}
+		if muted, err := store.IsMuted(channelID); err != nil || muted {
+			return false
+		}
+	}
+	return isChannelAllowed(channelID)
+}
+
+// IsNotificationAllowed is the exported wrapper around isNotificationAllowed
+// for callers outside the handler package, such as the events subsystem,
+// that need to gate a live notification on the current mute state before
+// emitting it. store may be nil, meaning no mute list is configured.
+func IsNotificationAllowed(channelID string, store MuteStore) bool {
+	return isNotificationAllowed(channelID, store)
+}
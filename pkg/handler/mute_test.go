@@ -0,0 +1,114 @@
+package handler
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInMemoryMuteStore(t *testing.T) {
+	s := NewInMemoryMuteStore()
+
+	muted, err := s.IsMuted("C1234567890")
+	require.NoError(t, err)
+	assert.False(t, muted)
+
+	require.NoError(t, s.Mute("C1234567890"))
+	muted, err = s.IsMuted("C1234567890")
+	require.NoError(t, err)
+	assert.True(t, muted)
+
+	list, err := s.List()
+	require.NoError(t, err)
+	assert.Equal(t, []string{"C1234567890"}, list)
+
+	require.NoError(t, s.Unmute("C1234567890"))
+	muted, err = s.IsMuted("C1234567890")
+	require.NoError(t, err)
+	assert.False(t, muted)
+
+	global, err := s.GlobalMuted()
+	require.NoError(t, err)
+	assert.False(t, global)
+	require.NoError(t, s.SetGlobalMute(true))
+	global, err = s.GlobalMuted()
+	require.NoError(t, err)
+	assert.True(t, global)
+}
+
+func TestFileMuteStorePersists(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "mute.json")
+
+	s1, err := NewFileMuteStore(path)
+	require.NoError(t, err)
+	require.NoError(t, s1.Mute("C1234567890"))
+	require.NoError(t, s1.SetGlobalMute(true))
+
+	s2, err := NewFileMuteStore(path)
+	require.NoError(t, err)
+
+	muted, err := s2.IsMuted("C1234567890")
+	require.NoError(t, err)
+	assert.True(t, muted)
+
+	global, err := s2.GlobalMuted()
+	require.NoError(t, err)
+	assert.True(t, global)
+}
+
+func TestMuteToolEmptyChannelTogglesGlobalMute(t *testing.T) {
+	store := NewInMemoryMuteStore()
+	tool := NewMuteTool(store)
+
+	require.NoError(t, tool.MuteChannel(""))
+	global, err := store.GlobalMuted()
+	require.NoError(t, err)
+	assert.True(t, global)
+
+	require.NoError(t, tool.UnmuteChannel(""))
+	global, err = store.GlobalMuted()
+	require.NoError(t, err)
+	assert.False(t, global)
+}
+
+func TestMuteToolRejectsInvalidChannel(t *testing.T) {
+	tool := NewMuteTool(NewInMemoryMuteStore())
+	assert.Error(t, tool.MuteChannel("not-a-channel"))
+}
+
+func TestIsNotificationAllowedMuteOverridesWhitelist(t *testing.T) {
+	t.Setenv("SLACK_MCP_ADD_MESSAGE_TOOL", "C1234567890,D0987654321")
+	store := NewInMemoryMuteStore()
+
+	// Whitelisted and not muted: notifications flow.
+	assert.True(t, isNotificationAllowed("C1234567890", store))
+
+	// Whitelisted but individually muted: mute wins for notifications...
+	require.NoError(t, store.Mute("C1234567890"))
+	assert.False(t, isNotificationAllowed("C1234567890", store))
+
+	// ...but isChannelAllowed itself is untouched, so explicit reads still work.
+	assert.True(t, isChannelAllowed("C1234567890"))
+}
+
+func TestIsNotificationAllowedGlobalMuteOverridesWhitelist(t *testing.T) {
+	t.Setenv("SLACK_MCP_ADD_MESSAGE_TOOL", "true")
+	store := NewInMemoryMuteStore()
+	require.NoError(t, store.SetGlobalMute(true))
+
+	assert.False(t, isNotificationAllowed("C1234567890", store))
+	assert.True(t, isChannelAllowed("C1234567890"))
+}
+
+func TestIsNotificationAllowedBlacklistStillApplies(t *testing.T) {
+	t.Setenv("SLACK_MCP_ADD_MESSAGE_TOOL", "!C1234567890")
+	store := NewInMemoryMuteStore()
+
+	// Blacklisted channel is denied regardless of mute state.
+	assert.False(t, isNotificationAllowed("C1234567890", store))
+
+	// A different channel, not blacklisted and not muted, is allowed.
+	assert.True(t, isNotificationAllowed("C9999999999", store))
+}
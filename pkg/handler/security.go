@@ -0,0 +1,199 @@
+package handler
+
+import (
+	"errors"
+	"fmt"
+	"html"
+	"os"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+)
+
+const (
+	// maxMessageLength is the maximum number of bytes sanitizeMessageContent
+	// will accept before rejecting a message outright.
+	maxMessageLength = 40000
+
+	// maxChannelNameLength bounds every form of channel identifier accepted
+	// by validateChannelIdentifier: Slack IDs, #-prefixed names and
+	// @-prefixed mentions alike.
+	maxChannelNameLength = 80
+
+	// maxThreadTsLength bounds a thread_ts value before validateThreadTimestamp
+	// attempts to parse it, matching the longest well-formed
+	// "seconds.microseconds" string.
+	maxThreadTsLength = 17
+
+	// MaxChannelNameLength is the exported form of maxChannelNameLength, for
+	// callers outside this package that build a candidate channel
+	// identifier and want to pre-check its length against the same bound
+	// ValidateChannelID enforces, without duplicating the literal.
+	MaxChannelNameLength = maxChannelNameLength
+)
+
+var (
+	channelIDPattern   = regexp.MustCompile(`^[CDG][A-Z0-9]{8,10}$`)
+	channelNameCharSet = regexp.MustCompile(`^[a-z0-9.-]+$`)
+	userMentionPattern = regexp.MustCompile(`^@[a-zA-Z0-9._-]+$`)
+	threadTsPattern    = regexp.MustCompile(`^\d{10}\.\d{6}$`)
+
+	// reservedChannelNames can never be used as a #-prefixed channel name,
+	// with the exception of "general" which is allowed when
+	// SLACK_MCP_ALLOW_GENERAL_CHANNEL is set.
+	reservedChannelNames = map[string]bool{
+		"general":  true,
+		"slackbot": true,
+		"everyone": true,
+		"channel":  true,
+		"here":     true,
+	}
+)
+
+var (
+	// ErrChannelEmpty is returned when a channel identifier is the empty string.
+	ErrChannelEmpty = errors.New("channel identifier cannot be empty")
+	// ErrChannelTooLong is returned when a channel identifier exceeds MaxChannelNameLength.
+	ErrChannelTooLong = fmt.Errorf("channel identifier exceeds maximum length of %d", maxChannelNameLength)
+	// ErrChannelIllegalChar is returned when a #-prefixed channel name contains
+	// a rune outside [a-z0-9.-], or doesn't start with a lowercase letter or digit.
+	ErrChannelIllegalChar = errors.New("channel name must start with a lowercase letter or digit and contain only [a-z0-9.-]")
+	// ErrChannelReserved is returned when a #-prefixed channel name collides
+	// with a name Slack reserves for system use.
+	ErrChannelReserved = errors.New("channel name is reserved")
+)
+
+// sanitizeMessageContent validates and normalizes a message body before it
+// is sent to Slack. Plain text is passed through untouched aside from the
+// length and encoding checks; markdown is HTML-escaped so that any embedded
+// markup renders as literal text instead of being interpreted.
+func sanitizeMessageContent(content, contentType string) (string, error) {
+	if !utf8.ValidString(content) {
+		return "", errors.New("message content is not valid UTF-8")
+	}
+	if len(content) > maxMessageLength {
+		return "", fmt.Errorf("message content exceeds maximum length of %d bytes", maxMessageLength)
+	}
+
+	if contentType == "text/markdown" {
+		return html.EscapeString(content), nil
+	}
+	return content, nil
+}
+
+// validateChannelIdentifier checks that channelID is one of the forms the
+// Slack API accepts: a Slack object ID (C/D/G prefix), a #-prefixed channel
+// name, or an @-prefixed user mention.
+func validateChannelIdentifier(channelID string) error {
+	if channelID == "" {
+		return ErrChannelEmpty
+	}
+	if !utf8.ValidString(channelID) {
+		return errors.New("channel identifier is not valid UTF-8")
+	}
+	if len(channelID) > maxChannelNameLength {
+		return ErrChannelTooLong
+	}
+
+	switch {
+	case channelIDPattern.MatchString(channelID):
+		return nil
+	case strings.HasPrefix(channelID, "#"):
+		return validateChannelName(channelID[1:])
+	case userMentionPattern.MatchString(channelID):
+		return nil
+	default:
+		return fmt.Errorf("invalid channel identifier: %q", channelID)
+	}
+}
+
+// validateChannelName applies Hyperledger Fabric-style format-category and
+// reserved-name checks to a #-prefixed channel name, name being the part
+// after the "#".
+func validateChannelName(name string) error {
+	if name == "" || !channelNameCharSet.MatchString(name) {
+		return ErrChannelIllegalChar
+	}
+
+	first := name[0]
+	isLowerOrDigit := (first >= 'a' && first <= 'z') || (first >= '0' && first <= '9')
+	if !isLowerOrDigit {
+		return ErrChannelIllegalChar
+	}
+
+	if reservedChannelNames[name] {
+		if name == "general" && os.Getenv("SLACK_MCP_ALLOW_GENERAL_CHANNEL") != "" {
+			return nil
+		}
+		return ErrChannelReserved
+	}
+
+	return nil
+}
+
+// ValidateChannelID is the exported wrapper around validateChannelIdentifier
+// for callers outside the handler package, such as the events subsystem,
+// that need to validate a channel identifier taken from a Slack payload or
+// before building a Slack API request.
+func ValidateChannelID(channelID string) error {
+	return validateChannelIdentifier(channelID)
+}
+
+// validateThreadTimestamp checks that threadTs, when non-empty, matches
+// Slack's "seconds.microseconds" timestamp format (10 digits, a dot, then
+// 6 digits). An empty string is allowed since thread_ts is optional on most
+// calls.
+func validateThreadTimestamp(threadTs string) error {
+	if threadTs == "" {
+		return nil
+	}
+	if !utf8.ValidString(threadTs) {
+		return errors.New("thread_ts is not valid UTF-8")
+	}
+	if len(threadTs) > maxThreadTsLength {
+		return fmt.Errorf("thread_ts exceeds maximum length of %d", maxThreadTsLength)
+	}
+	if !threadTsPattern.MatchString(threadTs) {
+		return fmt.Errorf("invalid thread_ts format: %q, expected \"seconds.microseconds\"", threadTs)
+	}
+	return nil
+}
+
+// ValidateThreadTimestamp is the exported wrapper around
+// validateThreadTimestamp for callers outside the handler package, such as
+// the events subsystem, that need to validate a ts/thread_ts value taken
+// from an incoming Slack payload.
+func ValidateThreadTimestamp(threadTs string) error {
+	return validateThreadTimestamp(threadTs)
+}
+
+// isChannelAllowed reports whether channel is permitted to receive the
+// message tools gated by SLACK_MCP_ADD_MESSAGE_TOOL. The env var is one of:
+// empty (deny everything), "true"/"1" (allow everything), a comma-separated
+// whitelist of channel IDs, or a comma-separated blacklist where every
+// entry is prefixed with "!".
+func isChannelAllowed(channel string) bool {
+	envVal := os.Getenv("SLACK_MCP_ADD_MESSAGE_TOOL")
+	if envVal == "" {
+		return false
+	}
+	if envVal == "true" || envVal == "1" {
+		return true
+	}
+
+	entries := strings.Split(envVal, ",")
+	isBlacklist := strings.HasPrefix(strings.TrimSpace(entries[0]), "!")
+
+	for _, entry := range entries {
+		entry = strings.TrimSpace(entry)
+		if isBlacklist {
+			if strings.TrimPrefix(entry, "!") == channel {
+				return false
+			}
+		} else if entry == channel {
+			return true
+		}
+	}
+
+	return isBlacklist
+}
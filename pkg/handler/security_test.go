@@ -118,9 +118,29 @@ func TestValidateChannelIdentifier(t *testing.T) {
 		},
 		{
 			name:        "Valid channel name with # prefix",
-			channelID:   "#general",
+			channelID:   "#standup",
 			expectError: false,
 		},
+		{
+			name:        "Reserved channel name general without opt-in",
+			channelID:   "#general",
+			expectError: true,
+		},
+		{
+			name:        "Reserved channel name slackbot",
+			channelID:   "#slackbot",
+			expectError: true,
+		},
+		{
+			name:        "Channel name with uppercase letter is illegal",
+			channelID:   "#General",
+			expectError: true,
+		},
+		{
+			name:        "Channel name starting with hyphen is illegal",
+			channelID:   "#-standup",
+			expectError: true,
+		},
 		{
 			name:        "Valid user mention with @ prefix",
 			channelID:   "@username",
@@ -166,7 +186,7 @@ func TestValidateChannelIdentifier(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			err := validateChannelIdentifier(tt.channelID)
-			
+
 			if tt.expectError {
 				assert.Error(t, err, "Expected error for channel ID: %s", tt.channelID)
 			} else {
@@ -176,6 +196,25 @@ func TestValidateChannelIdentifier(t *testing.T) {
 	}
 }
 
+func TestValidateChannelIdentifierTypedErrors(t *testing.T) {
+	assert.ErrorIs(t, validateChannelIdentifier(""), ErrChannelEmpty)
+	assert.ErrorIs(t, validateChannelIdentifier(strings.Repeat("A", maxChannelNameLength+1)), ErrChannelTooLong)
+	assert.ErrorIs(t, validateChannelIdentifier("#slackbot"), ErrChannelReserved)
+	assert.ErrorIs(t, validateChannelIdentifier("#General"), ErrChannelIllegalChar)
+}
+
+func TestValidateChannelIdentifierGeneralOptIn(t *testing.T) {
+	assert.ErrorIs(t, validateChannelIdentifier("#general"), ErrChannelReserved)
+
+	t.Setenv("SLACK_MCP_ALLOW_GENERAL_CHANNEL", "true")
+	assert.NoError(t, validateChannelIdentifier("#general"))
+}
+
+func TestValidateChannelID(t *testing.T) {
+	assert.NoError(t, ValidateChannelID("C1234567890"))
+	assert.ErrorIs(t, ValidateChannelID(""), ErrChannelEmpty)
+}
+
 func TestValidateThreadTimestamp(t *testing.T) {
 	tests := []struct {
 		name        string
@@ -0,0 +1,261 @@
+// Package importer ingests a Slack workspace export archive (the ZIP you get
+// from "Export" in a Slack admin console) and builds an in-memory corpus
+// that can be searched offline, without a live Slack API token. The
+// conversion rules mirror the ones Mattermost uses for its own Slack
+// importer, since the export format is the same regardless of destination.
+package importer
+
+import (
+	"archive/zip"
+	"encoding/json"
+	"fmt"
+	"path"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// SlackUser is the subset of a users.json entry the importer cares about.
+type SlackUser struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+// SlackChannel is the subset of a channels.json entry the importer cares
+// about.
+type SlackChannel struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+// rawMessage mirrors the fields the importer reads out of a per-channel
+// message export file; Slack's export includes many more fields that are
+// irrelevant here and are left for encoding/json to discard.
+type rawMessage struct {
+	Type string `json:"type"`
+	User string `json:"user"`
+	Text string `json:"text"`
+	Ts   string `json:"ts"`
+}
+
+// Message is a normalized, sanitized message ready to be served back to an
+// MCP client.
+type Message struct {
+	ChannelID string
+	UserID    string
+	Text      string
+	TsMillis  int64
+}
+
+// Corpus is the normalized, queryable result of importing a workspace
+// export: channels renamed per Slack's rules, users keyed by ID, and
+// messages with mentions rewritten and timestamps converted to Unix
+// milliseconds.
+type Corpus struct {
+	channels []SlackChannel
+	users    map[string]SlackUser
+	messages []Message
+}
+
+// Channels returns every channel discovered in the export.
+func (c *Corpus) Channels() []SlackChannel {
+	return c.channels
+}
+
+// Search returns every message in the corpus containing query as a
+// case-insensitive substring, optionally restricted to one channel ID. An
+// empty channelID searches all channels.
+func (c *Corpus) Search(channelID, query string) []Message {
+	query = strings.ToLower(query)
+	var matches []Message
+	for _, m := range c.messages {
+		if channelID != "" && m.ChannelID != channelID {
+			continue
+		}
+		if query != "" && !strings.Contains(strings.ToLower(m.Text), query) {
+			continue
+		}
+		matches = append(matches, m)
+	}
+	return matches
+}
+
+// Sanitizer matches handler.sanitizeMessageContent's signature so the
+// importer can reuse the handler package's content policy without importing
+// it directly and creating a cycle (handler.ImportTool passes its own
+// sanitizeMessageContent in).
+type Sanitizer func(content, contentType string) (string, error)
+
+var tsPattern = regexp.MustCompile(`^(\d+)\.(\d{1,6})$`)
+
+// ConvertTimestamp parses a Slack "seconds.microseconds" timestamp string and
+// returns the equivalent Unix time in milliseconds. It is looser than
+// validateThreadTimestamp: any number of seconds digits and 1 to 6
+// fractional digits are accepted, a short fractional part being padded out
+// to microseconds. Input without a dot-separated fractional part, or with
+// non-digit characters, is rejected.
+func ConvertTimestamp(ts string) (int64, error) {
+	m := tsPattern.FindStringSubmatch(ts)
+	if m == nil {
+		return 0, fmt.Errorf("invalid slack timestamp: %q", ts)
+	}
+
+	seconds, err := strconv.ParseInt(m[1], 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid slack timestamp seconds: %w", err)
+	}
+
+	micros, err := strconv.ParseInt(m[2], 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid slack timestamp microseconds: %w", err)
+	}
+	// Pad a short fractional part out to full microsecond precision, e.g.
+	// ".5" means 500000 microseconds, not 5.
+	for i := len(m[2]); i < 6; i++ {
+		micros *= 10
+	}
+
+	return seconds*1000 + micros/1000, nil
+}
+
+var leadingTrailingUnderscore = regexp.MustCompile(`^_+|_+$`)
+
+// ConvertChannelName rewrites a Slack export channel name into one that
+// satisfies Slack's own naming rules: lowercase, no leading/trailing
+// underscores, and never a bare single character or hyphen-leading name.
+func ConvertChannelName(name string) string {
+	name = strings.ToLower(name)
+	name = leadingTrailingUnderscore.ReplaceAllString(name, "")
+
+	if len(name) <= 1 || strings.HasPrefix(name, "-") {
+		return fmt.Sprintf("slack-channel-%s", name)
+	}
+	return name
+}
+
+var (
+	channelMentionPattern = regexp.MustCompile(`<!(channel|here|everyone)>`)
+	userMentionPattern    = regexp.MustCompile(`<@([A-Z0-9]+)(\|[^>]*)?>`)
+)
+
+var broadcastReplacement = map[string]string{
+	"channel":  "@channel",
+	"here":     "@here",
+	"everyone": "@all",
+}
+
+// ConvertUserMentions rewrites Slack's wire format for mentions into plain
+// @name text: <!channel>/<!here>/<!everyone> become @channel/@here/@all, and
+// <@Uxxx> or <@Uxxx|display> become @<username> looked up from users.
+func ConvertUserMentions(users []SlackUser, text string) string {
+	text = channelMentionPattern.ReplaceAllStringFunc(text, func(m string) string {
+		sub := channelMentionPattern.FindStringSubmatch(m)
+		return broadcastReplacement[sub[1]]
+	})
+
+	byID := make(map[string]string, len(users))
+	for _, u := range users {
+		byID[u.ID] = u.Name
+	}
+
+	return userMentionPattern.ReplaceAllStringFunc(text, func(m string) string {
+		sub := userMentionPattern.FindStringSubmatch(m)
+		if name, ok := byID[sub[1]]; ok {
+			return "@" + name
+		}
+		return "@" + sub[1]
+	})
+}
+
+// Import streams a Slack export ZIP at zipPath into a Corpus, running every
+// message through sanitize before it is stored.
+func Import(zipPath string, sanitize Sanitizer) (*Corpus, error) {
+	r, err := zip.OpenReader(zipPath)
+	if err != nil {
+		return nil, fmt.Errorf("open slack export %q: %w", zipPath, err)
+	}
+	defer r.Close()
+
+	corpus := &Corpus{users: make(map[string]SlackUser)}
+
+	for _, f := range r.File {
+		switch {
+		case f.Name == "channels.json":
+			if err := decodeZipJSON(f, &corpus.channels); err != nil {
+				return nil, fmt.Errorf("decode channels.json: %w", err)
+			}
+			for i := range corpus.channels {
+				corpus.channels[i].Name = ConvertChannelName(corpus.channels[i].Name)
+			}
+		case f.Name == "users.json":
+			var users []SlackUser
+			if err := decodeZipJSON(f, &users); err != nil {
+				return nil, fmt.Errorf("decode users.json: %w", err)
+			}
+			for _, u := range users {
+				corpus.users[u.ID] = u
+			}
+		case path.Ext(f.Name) == ".json":
+			// Per-channel, per-date message files live at "<channel>/<date>.json".
+			if err := importMessageFile(f, corpus, sanitize); err != nil {
+				return nil, fmt.Errorf("decode %s: %w", f.Name, err)
+			}
+		}
+	}
+
+	userList := make([]SlackUser, 0, len(corpus.users))
+	for _, u := range corpus.users {
+		userList = append(userList, u)
+	}
+	for i, m := range corpus.messages {
+		corpus.messages[i].Text = ConvertUserMentions(userList, m.Text)
+	}
+
+	return corpus, nil
+}
+
+func importMessageFile(f *zip.File, corpus *Corpus, sanitize Sanitizer) error {
+	var raws []rawMessage
+	if err := decodeZipJSON(f, &raws); err != nil {
+		return err
+	}
+
+	channelID := ConvertChannelName(path.Base(path.Dir(f.Name)))
+
+	for _, raw := range raws {
+		if raw.Type != "message" || raw.Text == "" {
+			continue
+		}
+
+		tsMillis, err := ConvertTimestamp(raw.Ts)
+		if err != nil {
+			return fmt.Errorf("message ts %q: %w", raw.Ts, err)
+		}
+
+		text := raw.Text
+		if sanitize != nil {
+			text, err = sanitize(raw.Text, "text/plain")
+			if err != nil {
+				return fmt.Errorf("sanitize message: %w", err)
+			}
+		}
+
+		corpus.messages = append(corpus.messages, Message{
+			ChannelID: channelID,
+			UserID:    raw.User,
+			Text:      text,
+			TsMillis:  tsMillis,
+		})
+	}
+
+	return nil
+}
+
+func decodeZipJSON(f *zip.File, v any) error {
+	rc, err := f.Open()
+	if err != nil {
+		return err
+	}
+	defer rc.Close()
+	return json.NewDecoder(rc).Decode(v)
+}
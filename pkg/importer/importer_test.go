@@ -0,0 +1,115 @@
+package importer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConvertTimestamp(t *testing.T) {
+	tests := []struct {
+		name        string
+		ts          string
+		expected    int64
+		expectError bool
+	}{
+		{
+			name:     "Whole seconds and microseconds",
+			ts:       "1234567890.123456",
+			expected: 1234567890123,
+		},
+		{
+			name:     "Short fractional part is padded",
+			ts:       "1234567890.5",
+			expected: 1234567890500,
+		},
+		{
+			name:        "Missing fractional part",
+			ts:          "1234567890",
+			expectError: true,
+		},
+		{
+			name:        "Non-numeric",
+			ts:          "not-a-timestamp",
+			expectError: true,
+		},
+		{
+			name:        "Empty string",
+			ts:          "",
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ConvertTimestamp(tt.ts)
+			if tt.expectError {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+}
+
+func TestConvertChannelName(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{name: "Lowercases", input: "General", expected: "general"},
+		{name: "Strips leading and trailing underscores", input: "_standup_", expected: "standup"},
+		{name: "Single character becomes slack-channel form", input: "x", expected: "slack-channel-x"},
+		{name: "Hyphen-leading becomes slack-channel form", input: "-ops", expected: "slack-channel--ops"},
+		{name: "Ordinary name is untouched", input: "eng-backend", expected: "eng-backend"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, ConvertChannelName(tt.input))
+		})
+	}
+}
+
+func TestConvertUserMentions(t *testing.T) {
+	users := []SlackUser{
+		{ID: "U1111", Name: "alice"},
+		{ID: "U2222", Name: "bob"},
+	}
+
+	tests := []struct {
+		name     string
+		text     string
+		expected string
+	}{
+		{
+			name:     "Broadcast mentions",
+			text:     "<!channel> heads up, <!here> and <!everyone>",
+			expected: "@channel heads up, @here and @all",
+		},
+		{
+			name:     "Plain user mention",
+			text:     "ping <@U1111>",
+			expected: "ping @alice",
+		},
+		{
+			name:     "User mention with display name",
+			text:     "ping <@U2222|bob.smith>",
+			expected: "ping @bob",
+		},
+		{
+			name:     "Unknown user id falls back to the raw id",
+			text:     "ping <@U9999>",
+			expected: "ping @U9999",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, ConvertUserMentions(users, tt.text))
+		})
+	}
+}